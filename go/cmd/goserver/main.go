@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/feast-dev/feast/go/internal/feast"
+	"github.com/feast-dev/feast/go/internal/telemetry"
+)
+
+const feastServerVersion = "0.1.0"
+
+func main() {
+	ctx := context.Background()
+
+	providers, err := telemetry.Init(ctx, telemetry.ConfigFromEnv(feastServerVersion))
+	if err != nil {
+		log.Fatalf("initializing telemetry: %v", err)
+	}
+	defer func() {
+		if err := providers.Shutdown(ctx); err != nil {
+			log.Printf("shutting down telemetry: %v", err)
+		}
+	}()
+
+	fs := feast.NewFeatureStore(
+		providers.TracerProvider.Tracer("github.com/feast-dev/feast/go/internal/feast"),
+		providers.Instruments,
+	)
+
+	addr := os.Getenv("FEAST_GRPC_ADDR")
+	if addr == "" {
+		addr = ":6566"
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("listening on %s: %v", addr, err)
+	}
+
+	grpcServer := NewGRPCServer(fs, providers)
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-sigCh
+		log.Printf("received %s, shutting down", sig)
+		grpcServer.GracefulStop()
+	}()
+
+	log.Printf("feast go server %s listening on %s", feastServerVersion, addr)
+	if err := grpcServer.Serve(listener); err != nil {
+		log.Fatalf("serving: %v", err)
+	}
+}
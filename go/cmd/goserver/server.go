@@ -2,22 +2,103 @@ package main
 
 import (
 	"context"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+
 	"github.com/feast-dev/feast/go/internal/feast"
+	"github.com/feast-dev/feast/go/internal/telemetry"
 	"github.com/feast-dev/feast/go/protos/feast/serving"
 )
 
 type servingServiceServer struct {
-	fs *feast.FeatureStore
+	fs          *feast.FeatureStore
+	tracer      trace.Tracer
+	instruments *telemetry.Instruments
 	serving.UnimplementedServingServiceServer
 }
 
+// NewServingServiceServer builds a servingServiceServer instrumented with
+// providers' TracerProvider and Instruments. Passing providers is what makes
+// OpenTelemetry a drop-in observability layer: nothing else in the serving
+// path needs to change to start emitting spans and metrics.
+func NewServingServiceServer(fs *feast.FeatureStore, providers *telemetry.Providers) *servingServiceServer {
+	return &servingServiceServer{
+		fs:          fs,
+		tracer:      providers.TracerProvider.Tracer("github.com/feast-dev/feast/go/cmd/goserver"),
+		instruments: providers.Instruments,
+	}
+}
+
+// NewGRPCServer constructs the serving gRPC server with the otelgrpc unary
+// interceptor installed, so every RPC becomes a span without each handler
+// having to start one explicitly.
+func NewGRPCServer(fs *feast.FeatureStore, providers *telemetry.Providers) *grpc.Server {
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
+	)
+	serving.RegisterServingServiceServer(grpcServer, NewServingServiceServer(fs, providers))
+	return grpcServer
+}
+
 func (s *servingServiceServer) GetFeastServingInfo(ctx context.Context, request *serving.GetFeastServingInfoRequest) (*serving.GetFeastServingInfoResponse, error) {
+	_, span := s.tracer.Start(ctx, "GetFeastServingInfo")
+	defer span.End()
+
 	return &serving.GetFeastServingInfoResponse{
 		Version: feastServerVersion,
 	}, nil
 }
 
 func (s *servingServiceServer) GetOnlineFeatures(ctx context.Context, request *serving.GetOnlineFeaturesRequest) (*serving.GetOnlineFeaturesResponse, error) {
-	s.fs.SetOnlineStoreContext(ctx)
-	return s.fs.GetOnlineFeatures(request)
-}
\ No newline at end of file
+	ctx, span := s.tracer.Start(ctx, "GetOnlineFeatures",
+		trace.WithAttributes(
+			attribute.String("feast.project", request.GetProject()),
+			attribute.Int("num_entities", numEntities(request)),
+		),
+	)
+	defer span.End()
+
+	attrs := metric.WithAttributes(attribute.String("feast.project", request.GetProject()))
+	s.instruments.InFlightRequests.Add(ctx, 1, attrs)
+	defer s.instruments.InFlightRequests.Add(ctx, -1, attrs)
+
+	// Per-feature-view latency and staleness metrics are recorded inside
+	// FeatureStore.GetOnlineFeatures, where each view is actually resolved.
+	response, err := s.fs.GetOnlineFeatures(ctx, request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *servingServiceServer) GetMaterializationStatus(ctx context.Context, request *serving.GetMaterializationStatusRequest) (*serving.GetMaterializationStatusResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "GetMaterializationStatus",
+		trace.WithAttributes(attribute.String("feast.project", request.GetProject())),
+	)
+	defer span.End()
+
+	response, err := s.fs.GetMaterializationStatus(ctx, request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	return response, nil
+}
+
+// numEntities returns the number of entity rows in an online features
+// request, for use as a span attribute.
+func numEntities(request *serving.GetOnlineFeaturesRequest) int {
+	entityValues := request.GetEntities()
+	for _, values := range entityValues {
+		return len(values.GetVal())
+	}
+	return 0
+}
@@ -0,0 +1,247 @@
+// Package feast implements the online feature retrieval path served by the
+// Go feature server: reading materialized feature values out of the online
+// store, evaluating on-demand transforms, and reporting why a given feature
+// value is or isn't present.
+package feast
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/feast-dev/feast/go/internal/telemetry"
+	"github.com/feast-dev/feast/go/protos/feast/core"
+	"github.com/feast-dev/feast/go/protos/feast/serving"
+)
+
+// FeatureStore serves online features for the feature views registered with
+// it. The registry holds only the feature views added with
+// RegisterFeatureView; nothing here loads them from the Feast registry.
+type FeatureStore struct {
+	tracer      trace.Tracer
+	instruments *telemetry.Instruments
+
+	featureViews map[featureViewKey]*registeredFeatureView
+}
+
+// featureViewKey scopes a registered feature view by project, since names
+// are only unique within a project and the serving API is always called
+// with one.
+type featureViewKey struct {
+	project string
+	name    string
+}
+
+// registeredFeatureView pairs a feature view with the MaterializationCoverage
+// derived from its meta, built once at registration time rather than
+// recomputed on every request that consults it.
+type registeredFeatureView struct {
+	fv       *core.FeatureView
+	coverage *core.MaterializationCoverage
+}
+
+// NewFeatureStore builds a FeatureStore that records spans against tracer
+// and metrics against instruments, so per-feature-view latency and staleness
+// are attributed at the point features are actually resolved.
+func NewFeatureStore(tracer trace.Tracer, instruments *telemetry.Instruments) *FeatureStore {
+	return &FeatureStore{
+		tracer:       tracer,
+		instruments:  instruments,
+		featureViews: make(map[featureViewKey]*registeredFeatureView),
+	}
+}
+
+// RegisterFeatureView adds fv to the store's registry, keyed by its spec's
+// project and name, so GetOnlineFeatures and GetMaterializationStatus can
+// consult its Ttl and materialization coverage. The coverage is merged from
+// fv's MaterializationIntervals once here, not on every request.
+func (fs *FeatureStore) RegisterFeatureView(fv *core.FeatureView) {
+	key := featureViewKey{project: fv.GetSpec().GetProject(), name: fv.GetSpec().GetName()}
+	fs.featureViews[key] = &registeredFeatureView{
+		fv:       fv,
+		coverage: core.NewMaterializationCoverage(fv.GetMeta().GetMaterializationIntervals()),
+	}
+}
+
+// lookupFeatureView is the only place that looks up a single entry of
+// fs.featureViews, so every caller gets the same nil-safe (rfv, ok) contract
+// instead of each one having to remember that an unregistered (project, name)
+// is expected and must not be dereferenced.
+func (fs *FeatureStore) lookupFeatureView(project, name string) (*registeredFeatureView, bool) {
+	rfv, ok := fs.featureViews[featureViewKey{project: project, name: name}]
+	return rfv, ok
+}
+
+// GetOnlineFeatures resolves request.FeatureViews for the requested entities
+// as of request.EventTimestamp (defaulting to now), instrumenting each
+// feature view's online-store read and on-demand transform evaluation with
+// its own span and recording per-view latency and staleness metrics. ctx
+// carries the calling RPC's cancellation/deadline and parents the spans
+// started here; it is a parameter rather than store state because a single
+// FeatureStore is shared across concurrent requests.
+func (fs *FeatureStore) GetOnlineFeatures(ctx context.Context, request *serving.GetOnlineFeaturesRequest) (*serving.GetOnlineFeaturesResponse, error) {
+	eventTime := time.Now()
+	if ts := request.GetEventTimestamp(); ts != nil {
+		eventTime = ts.AsTime()
+	}
+
+	project := request.GetProject()
+	response := &serving.GetOnlineFeaturesResponse{}
+	for _, viewName := range request.GetFeatureViews() {
+		start := time.Now()
+		viewAttrs := metric.WithAttributes(attribute.String("feature_view", viewName))
+
+		readCtx, readSpan := fs.tracer.Start(ctx, "OnlineRead", trace.WithAttributes(attribute.String("feature_view", viewName)))
+		vectors, rfv := fs.readOnlineStore(project, viewName, eventTime)
+		readSpan.End()
+
+		_, transformSpan := fs.tracer.Start(readCtx, "OnDemandTransform", trace.WithAttributes(attribute.String("feature_view", viewName)))
+		vectors = fs.applyOnDemandTransforms(vectors)
+		transformSpan.End()
+
+		var ttlSeconds int64
+		if rfv != nil {
+			ttlSeconds = rfv.fv.GetSpec().GetTtl().GetSeconds()
+		}
+		for _, vector := range vectors {
+			response.Results = append(response.Results, vector)
+			if vector.Status != serving.FieldStatus_PRESENT {
+				fs.instruments.RecordStaleFeature(ctx, viewName, vector.FeatureName, fieldStatusReason(vector.Status), ttlSeconds)
+			}
+		}
+
+		fs.instruments.RequestLatency.Record(ctx, float64(time.Since(start).Milliseconds()), viewAttrs)
+	}
+
+	return response, nil
+}
+
+// readOnlineStore looks up (project, viewName)'s registered feature view and
+// returns one FeatureVector per feature, with a status reflecting whether
+// eventTime is covered by the view's materialization intervals and within
+// its Ttl, plus the registered feature view itself so callers that need its
+// Ttl/spec don't have to look it up a second time. The returned
+// *registeredFeatureView is nil when viewName isn't registered.
+func (fs *FeatureStore) readOnlineStore(project, viewName string, eventTime time.Time) ([]*serving.GetOnlineFeaturesResponse_FeatureVector, *registeredFeatureView) {
+	rfv, ok := fs.lookupFeatureView(project, viewName)
+	if !ok {
+		return []*serving.GetOnlineFeaturesResponse_FeatureVector{{
+			FeatureView: viewName,
+			FeatureName: viewName,
+			Status:      serving.FieldStatus_NOT_FOUND,
+		}}, nil
+	}
+
+	status := fs.featureStatus(rfv, eventTime, time.Now())
+	features := rfv.fv.GetSpec().GetFeatures()
+	vectors := make([]*serving.GetOnlineFeaturesResponse_FeatureVector, 0, len(features))
+	for _, feature := range features {
+		vectors = append(vectors, &serving.GetOnlineFeaturesResponse_FeatureVector{
+			FeatureView: viewName,
+			FeatureName: feature.GetName(),
+			Status:      status,
+		})
+	}
+	return vectors, rfv
+}
+
+// applyOnDemandTransforms evaluates any on-demand feature view transforms
+// derived from vectors. There are none registered on plain FeatureViews, so
+// this is currently a pass-through; it exists as the instrumented extension
+// point on-demand transform evaluation hangs off of.
+func (fs *FeatureStore) applyOnDemandTransforms(vectors []*serving.GetOnlineFeaturesResponse_FeatureVector) []*serving.GetOnlineFeaturesResponse_FeatureVector {
+	return vectors
+}
+
+// featureStatus decides whether a feature view's value at eventTime should
+// be served as present, reported as materialization-gapped, or reported as
+// expired under the view's Ttl:
+//   - NOT_MATERIALIZED: eventTime isn't covered by any materialization
+//     interval at all, so there is nothing to serve.
+//   - OUTSIDE_MAX_AGE: eventTime is covered, but retrievalTime - eventTime
+//     exceeds the view's Ttl, so the value is too old to serve.
+//   - PRESENT: otherwise.
+func (fs *FeatureStore) featureStatus(rfv *registeredFeatureView, eventTime, retrievalTime time.Time) serving.FieldStatus {
+	if !rfv.coverage.Covers(eventTime) {
+		return serving.FieldStatus_NOT_MATERIALIZED
+	}
+
+	if ttl := rfv.fv.GetSpec().GetTtl().AsDuration(); ttl > 0 && retrievalTime.Sub(eventTime) > ttl {
+		return serving.FieldStatus_OUTSIDE_MAX_AGE
+	}
+	return serving.FieldStatus_PRESENT
+}
+
+func fieldStatusReason(status serving.FieldStatus) string {
+	switch status {
+	case serving.FieldStatus_NOT_MATERIALIZED:
+		return "not_materialized"
+	case serving.FieldStatus_OUTSIDE_MAX_AGE:
+		return "ttl_expired"
+	case serving.FieldStatus_NOT_FOUND:
+		return "not_found"
+	default:
+		return "null_value"
+	}
+}
+
+// GetMaterializationStatus summarizes the materialization coverage of each
+// requested feature view within request.Project (or every feature view
+// registered for that project, if none are named), for orchestrators
+// deciding whether to trigger a backfill before serving traffic.
+func (fs *FeatureStore) GetMaterializationStatus(ctx context.Context, request *serving.GetMaterializationStatusRequest) (*serving.GetMaterializationStatusResponse, error) {
+	project := request.GetProject()
+	names := request.GetFeatureViewNames()
+	if len(names) == 0 {
+		for key := range fs.featureViews {
+			if key.project == project {
+				names = append(names, key.name)
+			}
+		}
+	}
+
+	now := time.Now()
+	statuses := make(map[string]*serving.MaterializationStatus, len(names))
+	for _, name := range names {
+		rfv, ok := fs.lookupFeatureView(project, name)
+		if !ok {
+			continue
+		}
+		statuses[name] = fs.materializationStatus(rfv, now)
+	}
+	return &serving.GetMaterializationStatusResponse{FeatureViewStatuses: statuses}, nil
+}
+
+// materializationStatus reports rfv's last covered timestamp, the total gap
+// duration within the last Ttl window ending at now, and the oldest gap in
+// that window as the next recommended backfill range.
+func (fs *FeatureStore) materializationStatus(rfv *registeredFeatureView, now time.Time) *serving.MaterializationStatus {
+	status := &serving.MaterializationStatus{FeatureView: rfv.fv.GetSpec().GetName()}
+
+	if freshness := rfv.coverage.EffectiveFreshness(now); freshness >= 0 {
+		status.LastCoveredTimestamp = timestamppb.New(now.Add(-freshness))
+	}
+
+	ttl := rfv.fv.GetSpec().GetTtl().AsDuration()
+	if ttl <= 0 {
+		return status
+	}
+
+	gaps := rfv.coverage.Gaps(now.Add(-ttl), now)
+	var totalGap time.Duration
+	for _, gap := range gaps {
+		totalGap += gap.GetEndTime().AsTime().Sub(gap.GetStartTime().AsTime())
+	}
+	status.TotalGapDuration = durationpb.New(totalGap)
+
+	if len(gaps) > 0 {
+		status.NextBackfillStart = gaps[0].GetStartTime()
+		status.NextBackfillEnd = gaps[0].GetEndTime()
+	}
+	return status
+}
@@ -0,0 +1,131 @@
+package feast
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/feast-dev/feast/go/internal/telemetry"
+	"github.com/feast-dev/feast/go/protos/feast/core"
+	"github.com/feast-dev/feast/go/protos/feast/serving"
+)
+
+func newTestFeatureStore(t *testing.T) *FeatureStore {
+	instruments, err := telemetry.NewInstruments(noop.NewMeterProvider().Meter("test"))
+	if err != nil {
+		t.Fatalf("building test instruments: %v", err)
+	}
+	return NewFeatureStore(trace.NewNoopTracerProvider().Tracer("test"), instruments)
+}
+
+func TestGetOnlineFeatures_UnregisteredFeatureViewReturnsNotFound(t *testing.T) {
+	fs := newTestFeatureStore(t)
+
+	response, err := fs.GetOnlineFeatures(context.Background(), &serving.GetOnlineFeaturesRequest{
+		Project:      "driver_project",
+		FeatureViews: []string{"missing_view"},
+		Entities:     map[string]*serving.EntityValueList{"driver_id": {Val: []string{"1"}}},
+	})
+	if err != nil {
+		t.Fatalf("GetOnlineFeatures returned error: %v", err)
+	}
+	if len(response.Results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(response.Results))
+	}
+	if got := response.Results[0].Status; got != serving.FieldStatus_NOT_FOUND {
+		t.Fatalf("expected NOT_FOUND, got %v", got)
+	}
+}
+
+func TestGetOnlineFeatures_WrongProjectReturnsNotFound(t *testing.T) {
+	fs := newTestFeatureStore(t)
+	fs.RegisterFeatureView(&core.FeatureView{
+		Spec: &core.FeatureViewSpec{
+			Project:  "driver_project",
+			Name:     "driver_hourly_stats",
+			Features: []*core.FeatureSpecV2{{Name: "conv_rate"}},
+		},
+		Meta: &core.FeatureViewMeta{
+			MaterializationIntervals: []*core.MaterializationInterval{{
+				StartTime: timestamppb.New(time.Now().Add(-time.Hour)),
+				EndTime:   timestamppb.New(time.Now()),
+			}},
+		},
+	})
+
+	response, err := fs.GetOnlineFeatures(context.Background(), &serving.GetOnlineFeaturesRequest{
+		Project:      "other_project",
+		FeatureViews: []string{"driver_hourly_stats"},
+	})
+	if err != nil {
+		t.Fatalf("GetOnlineFeatures returned error: %v", err)
+	}
+	if len(response.Results) != 1 || response.Results[0].Status != serving.FieldStatus_NOT_FOUND {
+		t.Fatalf("expected a single NOT_FOUND result for a feature view registered under a different project, got %+v", response.Results)
+	}
+}
+
+func TestGetOnlineFeatures_RegisteredFeatureViewIsPresent(t *testing.T) {
+	fs := newTestFeatureStore(t)
+	now := time.Now()
+	fs.RegisterFeatureView(&core.FeatureView{
+		Spec: &core.FeatureViewSpec{
+			Project:  "driver_project",
+			Name:     "driver_hourly_stats",
+			Features: []*core.FeatureSpecV2{{Name: "conv_rate"}},
+		},
+		Meta: &core.FeatureViewMeta{
+			MaterializationIntervals: []*core.MaterializationInterval{{
+				StartTime: timestamppb.New(now.Add(-time.Hour)),
+				EndTime:   timestamppb.New(now),
+			}},
+		},
+	})
+
+	// EventTimestamp is pinned inside the materialized interval; left unset
+	// it would default to a later time.Now() past the interval's end.
+	response, err := fs.GetOnlineFeatures(context.Background(), &serving.GetOnlineFeaturesRequest{
+		Project:        "driver_project",
+		FeatureViews:   []string{"driver_hourly_stats"},
+		EventTimestamp: timestamppb.New(now),
+	})
+	if err != nil {
+		t.Fatalf("GetOnlineFeatures returned error: %v", err)
+	}
+	if len(response.Results) != 1 || response.Results[0].Status != serving.FieldStatus_PRESENT {
+		t.Fatalf("expected a single PRESENT result, got %+v", response.Results)
+	}
+}
+
+func TestGetMaterializationStatus_ScopedToProject(t *testing.T) {
+	fs := newTestFeatureStore(t)
+	fs.RegisterFeatureView(&core.FeatureView{
+		Spec: &core.FeatureViewSpec{Project: "driver_project", Name: "driver_hourly_stats"},
+		Meta: &core.FeatureViewMeta{
+			MaterializationIntervals: []*core.MaterializationInterval{{
+				StartTime: timestamppb.New(time.Now().Add(-time.Hour)),
+				EndTime:   timestamppb.New(time.Now()),
+			}},
+		},
+	})
+	fs.RegisterFeatureView(&core.FeatureView{
+		Spec: &core.FeatureViewSpec{Project: "other_project", Name: "driver_hourly_stats"},
+	})
+
+	response, err := fs.GetMaterializationStatus(context.Background(), &serving.GetMaterializationStatusRequest{
+		Project: "driver_project",
+	})
+	if err != nil {
+		t.Fatalf("GetMaterializationStatus returned error: %v", err)
+	}
+	if len(response.FeatureViewStatuses) != 1 {
+		t.Fatalf("expected exactly 1 status scoped to driver_project, got %d", len(response.FeatureViewStatuses))
+	}
+	if _, ok := response.FeatureViewStatuses["driver_hourly_stats"]; !ok {
+		t.Fatalf("expected a status for driver_hourly_stats, got %+v", response.FeatureViewStatuses)
+	}
+}
@@ -0,0 +1,73 @@
+package telemetry
+
+import "os"
+
+// ExporterKind selects which OTel exporter backs the TracerProvider and
+// MeterProvider constructed by Init.
+type ExporterKind string
+
+const (
+	// ExporterPrometheus scrapes metrics on a pull-based /metrics HTTP
+	// endpoint. Traces are written to stdout; OTLPEndpoint is not used.
+	ExporterPrometheus ExporterKind = "prometheus"
+	// ExporterOTLP pushes both traces and metrics to an OTLP gRPC collector.
+	ExporterOTLP ExporterKind = "otlp"
+	// ExporterStdout writes traces and metrics to stdout, useful for local
+	// development and debugging exporter configuration.
+	ExporterStdout ExporterKind = "stdout"
+)
+
+// Config controls how the Go feature server's OpenTelemetry providers are
+// constructed. Fields are typically populated from environment variables so
+// operators can point the server at their observability stack without
+// touching serving code.
+type Config struct {
+	// Exporter selects the metrics/traces backend. Defaults to
+	// ExporterPrometheus.
+	Exporter ExporterKind
+	// OTLPEndpoint is the OTLP gRPC collector address (host:port) used when
+	// Exporter is ExporterOTLP.
+	OTLPEndpoint string
+	// MetricsAddr is the listen address for the Prometheus /metrics endpoint,
+	// e.g. ":9100". Only used when Exporter is ExporterPrometheus.
+	MetricsAddr string
+	// ServiceName is the `service.name` resource attribute reported on every
+	// span and metric.
+	ServiceName string
+	// ServiceVersion is the `service.version` resource attribute. Callers
+	// typically pass feastServerVersion.
+	ServiceVersion string
+	// Environment is the deployment environment, e.g. "production" or
+	// "staging", reported as `deployment.environment`.
+	Environment string
+}
+
+const (
+	envExporter        = "FEAST_OTEL_EXPORTER"
+	envOTLPEndpoint    = "FEAST_OTEL_EXPORTER_OTLP_ENDPOINT"
+	envMetricsAddr     = "FEAST_METRICS_ADDR"
+	envEnvironment     = "FEAST_OTEL_DEPLOYMENT_ENVIRONMENT"
+	defaultMetricsAddr = ":9100"
+)
+
+// ConfigFromEnv builds a Config from environment variables, falling back to
+// a Prometheus exporter on defaultMetricsAddr when unset. serviceVersion is
+// threaded in by the caller (feastServerVersion) rather than read from the
+// environment.
+func ConfigFromEnv(serviceVersion string) Config {
+	cfg := Config{
+		Exporter:       ExporterKind(os.Getenv(envExporter)),
+		OTLPEndpoint:   os.Getenv(envOTLPEndpoint),
+		MetricsAddr:    os.Getenv(envMetricsAddr),
+		ServiceName:    "feast-go-server",
+		ServiceVersion: serviceVersion,
+		Environment:    os.Getenv(envEnvironment),
+	}
+	if cfg.Exporter == "" {
+		cfg.Exporter = ExporterPrometheus
+	}
+	if cfg.MetricsAddr == "" {
+		cfg.MetricsAddr = defaultMetricsAddr
+	}
+	return cfg
+}
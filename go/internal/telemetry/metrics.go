@@ -0,0 +1,67 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Instruments holds the OTel metric instruments recorded from the serving
+// path. RequestLatency and StaleResponses are keyed by feature view via the
+// "feature_view" attribute so operators can slice latency and staleness per
+// view; InFlightRequests is keyed by project via "feast.project".
+type Instruments struct {
+	RequestLatency   metric.Float64Histogram
+	InFlightRequests metric.Int64UpDownCounter
+	StaleResponses   metric.Int64Counter
+}
+
+// NewInstruments registers the serving instruments against meter. It is
+// called once, from Init, against the process-wide MeterProvider's Meter.
+func NewInstruments(meter metric.Meter) (*Instruments, error) {
+	requestLatency, err := meter.Float64Histogram(
+		"feast.serving.request.duration",
+		metric.WithDescription("Latency of GetOnlineFeatures requests, per feature view"),
+		metric.WithUnit("ms"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("registering feast.serving.request.duration: %w", err)
+	}
+
+	inFlight, err := meter.Int64UpDownCounter(
+		"feast.serving.requests.in_flight",
+		metric.WithDescription("Number of GetOnlineFeatures requests currently being served"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("registering feast.serving.requests.in_flight: %w", err)
+	}
+
+	staleResponses, err := meter.Int64Counter(
+		"feast.serving.feature.stale",
+		metric.WithDescription("Per-feature responses returned null due to a missing value or an expired Ttl"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("registering feast.serving.feature.stale: %w", err)
+	}
+
+	return &Instruments{
+		RequestLatency:   requestLatency,
+		InFlightRequests: inFlight,
+		StaleResponses:   staleResponses,
+	}, nil
+}
+
+// RecordStaleFeature records that a feature was returned as null because its
+// value was missing or its Ttl had expired.
+func (i *Instruments) RecordStaleFeature(ctx context.Context, featureView, featureName, reason string, ttlSeconds int64) {
+	i.StaleResponses.Add(ctx, 1,
+		metric.WithAttributes(
+			attribute.String("feature_view", featureView),
+			attribute.String("feature", featureName),
+			attribute.String("reason", reason),
+			attribute.Int64("ttl_seconds", ttlSeconds),
+		),
+	)
+}
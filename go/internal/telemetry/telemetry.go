@@ -0,0 +1,197 @@
+// Package telemetry wires the Go feature server into OpenTelemetry: a
+// TracerProvider and MeterProvider configured at startup and the
+// serving-specific instruments recorded from GetOnlineFeatures. The otelgrpc
+// interceptor itself is installed by the server's NewGRPCServer, not here.
+// It exists so operators can
+// SLO the server through standard OTel exporters without patching serving
+// code.
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Providers bundles the TracerProvider and MeterProvider constructed by
+// Init, plus the Instruments derived from the MeterProvider's Meter. The
+// serving server holds one Providers for its lifetime and passes it to
+// NewServingServiceServer.
+type Providers struct {
+	TracerProvider trace.TracerProvider
+	MeterProvider  metric.MeterProvider
+	Instruments    *Instruments
+
+	shutdownFuncs []func(context.Context) error
+	metricsServer *http.Server
+}
+
+// Init constructs the TracerProvider and MeterProvider described by cfg,
+// registers them as the global OTel providers, and — for ExporterPrometheus
+// — starts the /metrics HTTP listener on cfg.MetricsAddr. Callers must call
+// Shutdown when the server exits to flush pending spans/metrics and stop the
+// metrics listener.
+func Init(ctx context.Context, cfg Config) (*Providers, error) {
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(cfg.ServiceName),
+			semconv.ServiceVersionKey.String(cfg.ServiceVersion),
+			semconv.DeploymentEnvironmentKey.String(cfg.Environment),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("telemetry: building resource: %w", err)
+	}
+
+	p := &Providers{}
+
+	switch cfg.Exporter {
+	case ExporterOTLP:
+		err = p.initOTLP(ctx, cfg, res)
+	case ExporterStdout:
+		err = p.initStdout(res)
+	case ExporterPrometheus, "":
+		err = p.initPrometheus(cfg, res)
+	default:
+		err = fmt.Errorf("telemetry: unknown exporter %q", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	otel.SetTracerProvider(p.TracerProvider)
+	otel.SetMeterProvider(p.MeterProvider)
+
+	instruments, err := NewInstruments(p.MeterProvider.Meter("github.com/feast-dev/feast/go/internal/feast"))
+	if err != nil {
+		return nil, fmt.Errorf("telemetry: registering instruments: %w", err)
+	}
+	p.Instruments = instruments
+
+	return p, nil
+}
+
+func (p *Providers) initOTLP(ctx context.Context, cfg Config, res *resource.Resource) error {
+	traceExporter, err := otlptracegrpc.New(ctx,
+		otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint),
+		otlptracegrpc.WithInsecure(),
+	)
+	if err != nil {
+		return fmt.Errorf("telemetry: creating OTLP trace exporter: %w", err)
+	}
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(traceExporter),
+		sdktrace.WithResource(res),
+	)
+	p.TracerProvider = tracerProvider
+	p.shutdownFuncs = append(p.shutdownFuncs, tracerProvider.Shutdown)
+
+	metricExporter, err := otlpmetricgrpc.New(ctx,
+		otlpmetricgrpc.WithEndpoint(cfg.OTLPEndpoint),
+		otlpmetricgrpc.WithInsecure(),
+	)
+	if err != nil {
+		return fmt.Errorf("telemetry: creating OTLP metric exporter: %w", err)
+	}
+	meterProvider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter)),
+		sdkmetric.WithResource(res),
+	)
+	p.MeterProvider = meterProvider
+	p.shutdownFuncs = append(p.shutdownFuncs, meterProvider.Shutdown)
+	return nil
+}
+
+func (p *Providers) initStdout(res *resource.Resource) error {
+	traceExporter, err := stdouttrace.New()
+	if err != nil {
+		return fmt.Errorf("telemetry: creating stdout trace exporter: %w", err)
+	}
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(traceExporter),
+		sdktrace.WithResource(res),
+	)
+	p.TracerProvider = tracerProvider
+	p.shutdownFuncs = append(p.shutdownFuncs, tracerProvider.Shutdown)
+
+	metricExporter, err := stdoutmetric.New()
+	if err != nil {
+		return fmt.Errorf("telemetry: creating stdout metric exporter: %w", err)
+	}
+	meterProvider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter)),
+		sdkmetric.WithResource(res),
+	)
+	p.MeterProvider = meterProvider
+	p.shutdownFuncs = append(p.shutdownFuncs, meterProvider.Shutdown)
+	return nil
+}
+
+func (p *Providers) initPrometheus(cfg Config, res *resource.Resource) error {
+	// Prometheus is a pull exporter, so traces still need a push destination;
+	// stdout keeps the zero-config path free of a collector dependency. Set
+	// FEAST_OTEL_EXPORTER=otlp to ship both traces and metrics to a collector.
+	traceExporter, err := stdouttrace.New()
+	if err != nil {
+		return fmt.Errorf("telemetry: creating trace exporter: %w", err)
+	}
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(traceExporter),
+		sdktrace.WithResource(res),
+	)
+	p.TracerProvider = tracerProvider
+	p.shutdownFuncs = append(p.shutdownFuncs, tracerProvider.Shutdown)
+
+	promExporter, err := prometheus.New()
+	if err != nil {
+		return fmt.Errorf("telemetry: creating Prometheus exporter: %w", err)
+	}
+	meterProvider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(promExporter),
+		sdkmetric.WithResource(res),
+	)
+	p.MeterProvider = meterProvider
+	p.shutdownFuncs = append(p.shutdownFuncs, meterProvider.Shutdown)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	p.metricsServer = &http.Server{Addr: cfg.MetricsAddr, Handler: mux}
+	go func() {
+		_ = p.metricsServer.ListenAndServe()
+	}()
+
+	return nil
+}
+
+// Shutdown flushes pending spans/metrics and stops the /metrics listener, if
+// one was started.
+func (p *Providers) Shutdown(ctx context.Context) error {
+	var firstErr error
+	for _, fn := range p.shutdownFuncs {
+		if err := fn(ctx); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	if p.metricsServer != nil {
+		if err := p.metricsServer.Shutdown(ctx); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
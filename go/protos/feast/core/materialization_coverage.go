@@ -0,0 +1,120 @@
+package core
+
+import (
+	"sort"
+	"time"
+
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MaterializationCoverage is a normalized view over a FeatureViewMeta's
+// MaterializationIntervals: overlapping and adjacent intervals are merged
+// into a sorted, non-overlapping set so that callers can reason about what
+// time ranges have actually been backfilled into the online store. The
+// proto gives no ordering or non-overlap guarantee on
+// MaterializationIntervals, so this is built once via
+// NewMaterializationCoverage rather than read directly off the message.
+type MaterializationCoverage struct {
+	intervals []*MaterializationInterval
+}
+
+// NewMaterializationCoverage merges intervals, which may be overlapping,
+// adjacent, or out of order, into a MaterializationCoverage. Intervals with
+// a nil start/end time, or with end before start, are dropped.
+func NewMaterializationCoverage(intervals []*MaterializationInterval) *MaterializationCoverage {
+	return &MaterializationCoverage{intervals: mergeIntervals(intervals)}
+}
+
+func mergeIntervals(intervals []*MaterializationInterval) []*MaterializationInterval {
+	valid := make([]*MaterializationInterval, 0, len(intervals))
+	for _, interval := range intervals {
+		if interval.GetStartTime() == nil || interval.GetEndTime() == nil {
+			continue
+		}
+		if interval.GetEndTime().AsTime().Before(interval.GetStartTime().AsTime()) {
+			continue
+		}
+		valid = append(valid, interval)
+	}
+	sort.Slice(valid, func(i, j int) bool {
+		return valid[i].GetStartTime().AsTime().Before(valid[j].GetStartTime().AsTime())
+	})
+
+	merged := make([]*MaterializationInterval, 0, len(valid))
+	for _, interval := range valid {
+		if len(merged) > 0 {
+			last := merged[len(merged)-1]
+			if !interval.GetStartTime().AsTime().After(last.GetEndTime().AsTime()) {
+				if interval.GetEndTime().AsTime().After(last.GetEndTime().AsTime()) {
+					last.EndTime = interval.GetEndTime()
+				}
+				continue
+			}
+		}
+		merged = append(merged, &MaterializationInterval{
+			StartTime: interval.GetStartTime(),
+			EndTime:   interval.GetEndTime(),
+		})
+	}
+	return merged
+}
+
+// Covers reports whether t falls within a materialized interval.
+func (c *MaterializationCoverage) Covers(t time.Time) bool {
+	for _, interval := range c.intervals {
+		if !t.Before(interval.GetStartTime().AsTime()) && !t.After(interval.GetEndTime().AsTime()) {
+			return true
+		}
+	}
+	return false
+}
+
+// Gaps returns the portions of [from, to) not covered by any materialized
+// interval, in chronological order. An empty result means [from, to) is
+// fully covered.
+func (c *MaterializationCoverage) Gaps(from, to time.Time) []*MaterializationInterval {
+	if !from.Before(to) {
+		return nil
+	}
+
+	var gaps []*MaterializationInterval
+	cursor := from
+	for _, interval := range c.intervals {
+		start, end := interval.GetStartTime().AsTime(), interval.GetEndTime().AsTime()
+		if !end.After(cursor) || !start.Before(to) {
+			continue
+		}
+		if start.After(cursor) {
+			gaps = append(gaps, &MaterializationInterval{
+				StartTime: timestamppb.New(cursor),
+				EndTime:   timestamppb.New(start),
+			})
+		}
+		if end.After(cursor) {
+			cursor = end
+		}
+		if !cursor.Before(to) {
+			return gaps
+		}
+	}
+	gaps = append(gaps, &MaterializationInterval{
+		StartTime: timestamppb.New(cursor),
+		EndTime:   timestamppb.New(to),
+	})
+	return gaps
+}
+
+// EffectiveFreshness returns how long ago the most recently materialized
+// interval ended, relative to now. It is zero when now itself is covered,
+// and grows as the gap since the last materialization widens. It returns -1
+// when there are no materialization intervals at all.
+func (c *MaterializationCoverage) EffectiveFreshness(now time.Time) time.Duration {
+	if len(c.intervals) == 0 {
+		return -1
+	}
+	lastEnd := c.intervals[len(c.intervals)-1].GetEndTime().AsTime()
+	if now.Before(lastEnd) {
+		return 0
+	}
+	return now.Sub(lastEnd)
+}
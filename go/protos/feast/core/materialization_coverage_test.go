@@ -0,0 +1,155 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func interval(start, end time.Time) *MaterializationInterval {
+	return &MaterializationInterval{
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+	}
+}
+
+func day(n int) time.Time {
+	return time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, n)
+}
+
+func TestNewMaterializationCoverage_MergesOverlapping(t *testing.T) {
+	c := NewMaterializationCoverage([]*MaterializationInterval{
+		interval(day(0), day(3)),
+		interval(day(2), day(5)),
+	})
+	if len(c.intervals) != 1 {
+		t.Fatalf("expected overlapping intervals to merge into 1, got %d", len(c.intervals))
+	}
+	if !c.intervals[0].GetStartTime().AsTime().Equal(day(0)) || !c.intervals[0].GetEndTime().AsTime().Equal(day(5)) {
+		t.Fatalf("expected merged interval [day0, day5), got [%v, %v)", c.intervals[0].GetStartTime().AsTime(), c.intervals[0].GetEndTime().AsTime())
+	}
+}
+
+func TestNewMaterializationCoverage_MergesAdjacent(t *testing.T) {
+	c := NewMaterializationCoverage([]*MaterializationInterval{
+		interval(day(0), day(2)),
+		interval(day(2), day(4)),
+	})
+	if len(c.intervals) != 1 {
+		t.Fatalf("expected adjacent intervals to merge into 1, got %d", len(c.intervals))
+	}
+}
+
+func TestNewMaterializationCoverage_OutOfOrderInput(t *testing.T) {
+	c := NewMaterializationCoverage([]*MaterializationInterval{
+		interval(day(6), day(8)),
+		interval(day(0), day(2)),
+		interval(day(3), day(5)),
+	})
+	if len(c.intervals) != 3 {
+		t.Fatalf("expected 3 disjoint intervals, got %d", len(c.intervals))
+	}
+	if !c.intervals[0].GetStartTime().AsTime().Equal(day(0)) {
+		t.Fatalf("expected intervals to be sorted by start time, got first start %v", c.intervals[0].GetStartTime().AsTime())
+	}
+}
+
+func TestNewMaterializationCoverage_DropsInvalidIntervals(t *testing.T) {
+	c := NewMaterializationCoverage([]*MaterializationInterval{
+		{StartTime: timestamppb.New(day(0))}, // no end time
+		interval(day(5), day(3)),             // end before start
+		interval(day(0), day(1)),
+	})
+	if len(c.intervals) != 1 {
+		t.Fatalf("expected invalid intervals to be dropped, got %d intervals", len(c.intervals))
+	}
+}
+
+func TestCovers(t *testing.T) {
+	c := NewMaterializationCoverage([]*MaterializationInterval{
+		interval(day(0), day(2)),
+		interval(day(4), day(6)),
+	})
+	cases := []struct {
+		t    time.Time
+		want bool
+	}{
+		{day(0), true},
+		{day(1), true},
+		{day(2), true},
+		{day(3), false},
+		{day(5), true},
+		{day(7), false},
+	}
+	for _, tc := range cases {
+		if got := c.Covers(tc.t); got != tc.want {
+			t.Errorf("Covers(%v) = %v, want %v", tc.t, got, tc.want)
+		}
+	}
+}
+
+func TestGaps_NoCoverage(t *testing.T) {
+	c := NewMaterializationCoverage(nil)
+	gaps := c.Gaps(day(0), day(5))
+	if len(gaps) != 1 {
+		t.Fatalf("expected 1 gap covering the whole range, got %d", len(gaps))
+	}
+	if !gaps[0].GetStartTime().AsTime().Equal(day(0)) || !gaps[0].GetEndTime().AsTime().Equal(day(5)) {
+		t.Fatalf("expected gap [day0, day5), got [%v, %v)", gaps[0].GetStartTime().AsTime(), gaps[0].GetEndTime().AsTime())
+	}
+}
+
+func TestGaps_BetweenIntervals(t *testing.T) {
+	c := NewMaterializationCoverage([]*MaterializationInterval{
+		interval(day(0), day(2)),
+		interval(day(4), day(6)),
+	})
+	gaps := c.Gaps(day(0), day(6))
+	if len(gaps) != 1 {
+		t.Fatalf("expected 1 gap, got %d", len(gaps))
+	}
+	if !gaps[0].GetStartTime().AsTime().Equal(day(2)) || !gaps[0].GetEndTime().AsTime().Equal(day(4)) {
+		t.Fatalf("expected gap [day2, day4), got [%v, %v)", gaps[0].GetStartTime().AsTime(), gaps[0].GetEndTime().AsTime())
+	}
+}
+
+func TestGaps_FullyCovered(t *testing.T) {
+	c := NewMaterializationCoverage([]*MaterializationInterval{
+		interval(day(0), day(10)),
+	})
+	if gaps := c.Gaps(day(2), day(5)); len(gaps) != 0 {
+		t.Fatalf("expected no gaps within a fully covered range, got %d", len(gaps))
+	}
+}
+
+func TestGaps_OutOfOrderInputStillProducesOrderedGaps(t *testing.T) {
+	c := NewMaterializationCoverage([]*MaterializationInterval{
+		interval(day(8), day(10)),
+		interval(day(0), day(2)),
+	})
+	gaps := c.Gaps(day(0), day(10))
+	if len(gaps) != 1 {
+		t.Fatalf("expected 1 gap, got %d", len(gaps))
+	}
+	if !gaps[0].GetStartTime().AsTime().Equal(day(2)) || !gaps[0].GetEndTime().AsTime().Equal(day(8)) {
+		t.Fatalf("expected gap [day2, day8), got [%v, %v)", gaps[0].GetStartTime().AsTime(), gaps[0].GetEndTime().AsTime())
+	}
+}
+
+func TestEffectiveFreshness(t *testing.T) {
+	c := NewMaterializationCoverage([]*MaterializationInterval{
+		interval(day(0), day(2)),
+	})
+	if got := c.EffectiveFreshness(day(1)); got != 0 {
+		t.Errorf("EffectiveFreshness(day1) = %v, want 0", got)
+	}
+	if got := c.EffectiveFreshness(day(5)); got != 3*24*time.Hour {
+		t.Errorf("EffectiveFreshness(day5) = %v, want %v", got, 3*24*time.Hour)
+	}
+
+	empty := NewMaterializationCoverage(nil)
+	if got := empty.EffectiveFreshness(day(0)); got != -1 {
+		t.Errorf("EffectiveFreshness on empty coverage = %v, want -1", got)
+	}
+}
@@ -0,0 +1,158 @@
+package serving
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FieldStatus describes why a single feature value in a GetOnlineFeatures
+// response has the value it does.
+type FieldStatus int32
+
+const (
+	FieldStatus_INVALID         FieldStatus = 0
+	FieldStatus_PRESENT         FieldStatus = 1
+	FieldStatus_NULL_VALUE      FieldStatus = 2
+	FieldStatus_NOT_FOUND       FieldStatus = 3
+	FieldStatus_OUTSIDE_MAX_AGE FieldStatus = 4
+	// FieldStatus_NOT_MATERIALIZED indicates the requested event timestamp
+	// falls outside any MaterializationInterval recorded on the feature
+	// view's meta, as distinct from FieldStatus_OUTSIDE_MAX_AGE, which means
+	// the value was materialized but is now older than the view's Ttl.
+	FieldStatus_NOT_MATERIALIZED FieldStatus = 5
+)
+
+// EntityValueList is the list of values for a single entity column in a
+// GetOnlineFeatures request.
+type EntityValueList struct {
+	Val []string
+}
+
+func (x *EntityValueList) GetVal() []string {
+	if x != nil {
+		return x.Val
+	}
+	return nil
+}
+
+type GetFeastServingInfoRequest struct{}
+
+type GetFeastServingInfoResponse struct {
+	Version string
+}
+
+func (x *GetFeastServingInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// GetOnlineFeaturesRequest requests feature values for a set of entities
+// across one or more feature views.
+type GetOnlineFeaturesRequest struct {
+	Project      string
+	FeatureViews []string
+	Entities     map[string]*EntityValueList
+	// EventTimestamp is the point in time features are requested as-of. It
+	// defaults to the current time when unset.
+	EventTimestamp *timestamppb.Timestamp
+}
+
+func (x *GetOnlineFeaturesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *GetOnlineFeaturesRequest) GetFeatureViews() []string {
+	if x != nil {
+		return x.FeatureViews
+	}
+	return nil
+}
+
+func (x *GetOnlineFeaturesRequest) GetEntities() map[string]*EntityValueList {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *GetOnlineFeaturesRequest) GetEventTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventTimestamp
+	}
+	return nil
+}
+
+// GetOnlineFeaturesResponse_FeatureVector is the set of values for a single
+// feature across all requested entity rows, alongside a per-feature status.
+type GetOnlineFeaturesResponse_FeatureVector struct {
+	FeatureView string
+	FeatureName string
+	Status      FieldStatus
+}
+
+type GetOnlineFeaturesResponse struct {
+	Results []*GetOnlineFeaturesResponse_FeatureVector
+}
+
+func (x *GetOnlineFeaturesResponse) GetResults() []*GetOnlineFeaturesResponse_FeatureVector {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// GetMaterializationStatusRequest asks for the materialization coverage of
+// featureViewNames within project. An empty FeatureViewNames requests the
+// status of every feature view in the project.
+type GetMaterializationStatusRequest struct {
+	Project          string
+	FeatureViewNames []string
+}
+
+func (x *GetMaterializationStatusRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *GetMaterializationStatusRequest) GetFeatureViewNames() []string {
+	if x != nil {
+		return x.FeatureViewNames
+	}
+	return nil
+}
+
+// MaterializationStatus summarizes one feature view's materialization
+// coverage, for orchestrators deciding whether to trigger a backfill before
+// serving traffic.
+type MaterializationStatus struct {
+	FeatureView string
+	// LastCoveredTimestamp is the end of the most recent materialization
+	// interval, capped at the time of the request when that end lies in the
+	// future, or nil if the feature view has never been materialized.
+	LastCoveredTimestamp *timestamppb.Timestamp
+	// TotalGapDuration is the total uncovered time within the last Ttl
+	// window, ending now.
+	TotalGapDuration *durationpb.Duration
+	// NextBackfillStart and NextBackfillEnd bound the recommended backfill
+	// range: the oldest gap within the last Ttl window, if any.
+	NextBackfillStart *timestamppb.Timestamp
+	NextBackfillEnd   *timestamppb.Timestamp
+}
+
+type GetMaterializationStatusResponse struct {
+	FeatureViewStatuses map[string]*MaterializationStatus
+}
+
+func (x *GetMaterializationStatusResponse) GetFeatureViewStatuses() map[string]*MaterializationStatus {
+	if x != nil {
+		return x.FeatureViewStatuses
+	}
+	return nil
+}
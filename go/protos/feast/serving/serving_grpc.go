@@ -0,0 +1,108 @@
+package serving
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// ServingServiceServer is the server API for ServingService.
+type ServingServiceServer interface {
+	GetFeastServingInfo(context.Context, *GetFeastServingInfoRequest) (*GetFeastServingInfoResponse, error)
+	GetOnlineFeatures(context.Context, *GetOnlineFeaturesRequest) (*GetOnlineFeaturesResponse, error)
+	GetMaterializationStatus(context.Context, *GetMaterializationStatusRequest) (*GetMaterializationStatusResponse, error)
+	mustEmbedUnimplementedServingServiceServer()
+}
+
+// UnimplementedServingServiceServer must be embedded by any implementation
+// of ServingServiceServer to satisfy forward compatibility: new RPCs added
+// to the interface get a default "unimplemented" behavior instead of
+// breaking the build.
+type UnimplementedServingServiceServer struct{}
+
+func (UnimplementedServingServiceServer) GetFeastServingInfo(context.Context, *GetFeastServingInfoRequest) (*GetFeastServingInfoResponse, error) {
+	return nil, grpcNotImplemented("GetFeastServingInfo")
+}
+
+func (UnimplementedServingServiceServer) GetOnlineFeatures(context.Context, *GetOnlineFeaturesRequest) (*GetOnlineFeaturesResponse, error) {
+	return nil, grpcNotImplemented("GetOnlineFeatures")
+}
+
+func (UnimplementedServingServiceServer) GetMaterializationStatus(context.Context, *GetMaterializationStatusRequest) (*GetMaterializationStatusResponse, error) {
+	return nil, grpcNotImplemented("GetMaterializationStatus")
+}
+
+func (UnimplementedServingServiceServer) mustEmbedUnimplementedServingServiceServer() {}
+
+func grpcNotImplemented(method string) error {
+	return &notImplementedError{method: method}
+}
+
+type notImplementedError struct{ method string }
+
+func (e *notImplementedError) Error() string {
+	return "method " + e.method + " not implemented"
+}
+
+// RegisterServingServiceServer registers srv with s so incoming RPCs for
+// ServingService are dispatched to it.
+func RegisterServingServiceServer(s grpc.ServiceRegistrar, srv ServingServiceServer) {
+	s.RegisterService(&servingServiceServiceDesc, srv)
+}
+
+func servingServiceGetFeastServingInfoHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeastServingInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServingServiceServer).GetFeastServingInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/feast.serving.ServingService/GetFeastServingInfo"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServingServiceServer).GetFeastServingInfo(ctx, req.(*GetFeastServingInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func servingServiceGetOnlineFeaturesHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOnlineFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServingServiceServer).GetOnlineFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/feast.serving.ServingService/GetOnlineFeatures"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServingServiceServer).GetOnlineFeatures(ctx, req.(*GetOnlineFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func servingServiceGetMaterializationStatusHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaterializationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServingServiceServer).GetMaterializationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/feast.serving.ServingService/GetMaterializationStatus"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServingServiceServer).GetMaterializationStatus(ctx, req.(*GetMaterializationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var servingServiceServiceDesc = grpc.ServiceDesc{
+	ServiceName: "feast.serving.ServingService",
+	HandlerType: (*ServingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "GetFeastServingInfo", Handler: servingServiceGetFeastServingInfoHandler},
+		{MethodName: "GetOnlineFeatures", Handler: servingServiceGetOnlineFeaturesHandler},
+		{MethodName: "GetMaterializationStatus", Handler: servingServiceGetMaterializationStatusHandler},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feast/serving/ServingService.proto",
+}